@@ -0,0 +1,66 @@
+package cutter
+
+import (
+	"image"
+	"testing"
+)
+
+func TestAlignedRectAllNinePositions(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 100, 60))
+
+	tests := []struct {
+		name  string
+		align AnchorMode
+		want  image.Rectangle
+	}{
+		{"AlignTopLeft", AlignTopLeft, image.Rect(0, 0, 20, 10)},
+		{"AlignTop", AlignTop, image.Rect(40, 0, 60, 10)},
+		{"AlignTopRight", AlignTopRight, image.Rect(80, 0, 100, 10)},
+		{"AlignLeft", AlignLeft, image.Rect(0, 25, 20, 35)},
+		{"AlignCenter", AlignCenter, image.Rect(40, 25, 60, 35)},
+		{"AlignRight", AlignRight, image.Rect(80, 25, 100, 35)},
+		{"AlignBottomLeft", AlignBottomLeft, image.Rect(0, 50, 20, 60)},
+		{"AlignBottom", AlignBottom, image.Rect(40, 50, 60, 60)},
+		{"AlignBottomRight", AlignBottomRight, image.Rect(80, 50, 100, 60)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := CropAligned(src, 20, 10, tt.align, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := out.Bounds(); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCropAlignedWithRatio(t *testing.T) {
+	// The request's own canonical example: the biggest 16:9 crop,
+	// anchored to the bottom, out of a 100x60 source.
+	src := image.NewRGBA(image.Rect(0, 0, 100, 60))
+	out, err := CropAligned(src, 16, 9, AlignBottom, Ratio)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := image.Rect(0, 6, 100, 60)
+	if got := out.Bounds(); got != want {
+		t.Errorf("got %v, want %v", got, want)
+	}
+}
+
+func TestAlignedRectClipsToSourceBounds(t *testing.T) {
+	// A request bigger than the source in both dimensions: the aligned
+	// rectangle extends past the source on two sides, but the final
+	// result must still be clipped to the source bounds.
+	src := image.NewRGBA(image.Rect(0, 0, 100, 60))
+	out, err := CropAligned(src, 150, 100, AlignBottomRight, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out.Bounds(); got != src.Bounds() {
+		t.Errorf("got %v, want %v", got, src.Bounds())
+	}
+}
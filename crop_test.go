@@ -0,0 +1,58 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// plainImage wraps an image.Image exposing only the image.Image methods,
+// deliberately not implementing subImager, to exercise Crop's fallback
+// copy path.
+type plainImage struct {
+	image.Image
+}
+
+func TestCropSubImageFastPathSharesBuffer(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	out, err := Crop(src, Config{Width: 4, Height: 4, Mode: Centered})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fill := color.RGBA{1, 2, 3, 255}
+	src.Set(4, 4, fill) // inside the 4x4 centered crop region
+	assertColor(t, out, 4, 4, fill)
+}
+
+func TestCropOptionCopyDetachesBuffer(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	before := color.RGBAModel.Convert(src.At(4, 4)).(color.RGBA)
+
+	out, err := Crop(src, Config{Width: 4, Height: 4, Mode: Centered, Options: Copy})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	src.Set(4, 4, color.RGBA{255, 0, 0, 255})
+	assertColor(t, out, 4, 4, before)
+}
+
+func TestCropFallsBackToCopyForNonSubImager(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	fill := color.RGBA{9, 9, 9, 255}
+	src.Set(4, 4, fill)
+	wrapped := plainImage{src}
+
+	out, err := Crop(wrapped, Config{Width: 4, Height: 4, Mode: Centered})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := out.(plainImage); ok {
+		t.Fatalf("expected a copied image, got the wrapped plainImage back")
+	}
+	assertColor(t, out, 4, 4, fill)
+
+	src.Set(4, 4, color.RGBA{255, 0, 0, 255})
+	assertColor(t, out, 4, 4, fill)
+}
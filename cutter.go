@@ -39,11 +39,50 @@ from the anchor position.
 		  Mode: Centered,
 		  Options: Ratio,
 		})
+
+Instead of an explicit Anchor point, Mode can be set to one of the
+9-point compass alignments (AlignTop, AlignBottomRight, ...) so the
+anchor is derived from the source image bounds:
+
+		croppedImg, err := cutter.CropAligned(img, 250, 500, cutter.AlignBottomRight, 0)
+
+When the subject position isn't known in advance, Mode: SmartCrop picks
+the Width x Height (or Ratio-derived) window that maximizes a
+content-aware interest score instead of using a fixed anchor:
+
+		croppedImg, err := cutter.Crop(img, cutter.Config{
+		  Width: 1,
+		  Height: 1,
+		  Mode: SmartCrop,
+		  Options: Ratio,
+		})
+
+Config.Rotation rotates the crop window (in degrees, clockwise) around
+its center before sampling, and CropReader can normalize a JPEG's EXIF
+orientation first:
+
+		croppedImg, err := cutter.CropReader(r, cutter.Config{
+		  Width: 250,
+		  Height: 500,
+		  Mode: Centered,
+		  Rotation: 15,
+		  AutoOrient: true,
+		})
+
+For very large images, CropTiled walks the crop region in fixed-size
+tiles instead of allocating it all at once, and CropInto draws straight
+into a caller-provided destination:
+
+		err := cutter.CropTiled(img, cutter.Config{Width: 4000, Height: 4000}, 512,
+		  func(tile *image.RGBA, r image.Rectangle) error {
+		    return writeTile(tile, r)
+		  })
 */
 package cutter
 
 import (
 	"image"
+	"image/draw"
 )
 
 // Config	is used to defined
@@ -53,6 +92,10 @@ type Config struct {
 	Anchor        image.Point // The Anchor Point in the source image
 	Mode          AnchorMode  // Which point in the resulting image the Anchor Point is referring to
 	Options       Option
+	SmartCrop     SmartCropOptions // Tuning knobs used when Mode is SmartCrop
+	Padding       Padding          // How to fill the crop rectangle when it exceeds the source bounds
+	Rotation      float64          // Degrees, clockwise, to rotate the crop window around its center before sampling
+	AutoOrient    bool             // CropReader only: normalize EXIF orientation before cropping
 }
 
 // AnchorMode is an enumeration of the position an anchor can represent.
@@ -65,8 +108,48 @@ const (
 	// Centered defines the Anchor Point
 	// as the center of the cropped picture.
 	Centered = iota
+
+	// AlignTop anchors the crop against the top edge of the source
+	// image, centered horizontally. Anchor is ignored.
+	AlignTop
+	// AlignBottom anchors the crop against the bottom edge of the
+	// source image, centered horizontally. Anchor is ignored.
+	AlignBottom
+	// AlignLeft anchors the crop against the left edge of the source
+	// image, centered vertically. Anchor is ignored.
+	AlignLeft
+	// AlignRight anchors the crop against the right edge of the
+	// source image, centered vertically. Anchor is ignored.
+	AlignRight
+	// AlignCenter anchors the crop to the center of the source
+	// image. Anchor is ignored.
+	AlignCenter
+	// AlignTopLeft anchors the crop to the top left corner of the
+	// source image. Anchor is ignored.
+	AlignTopLeft
+	// AlignTopRight anchors the crop to the top right corner of the
+	// source image. Anchor is ignored.
+	AlignTopRight
+	// AlignBottomLeft anchors the crop to the bottom left corner of
+	// the source image. Anchor is ignored.
+	AlignBottomLeft
+	// AlignBottomRight anchors the crop to the bottom right corner
+	// of the source image. Anchor is ignored.
+	AlignBottomRight
+
+	// SmartCrop anchors the crop to the window within the source image
+	// that maximizes a content-aware "interest" score (edges,
+	// saturation, skin tones) instead of a fixed anchor point. Tune it
+	// via Config.SmartCrop. Anchor is ignored.
+	SmartCrop
 )
 
+// isAligned reports whether m is one of the 9-point compass alignments,
+// which derive their anchor from the source bounds rather than Anchor.
+func (m AnchorMode) isAligned() bool {
+	return m >= AlignTop && m <= AlignBottomRight
+}
+
 // Option flags to modify the way the crop is done.
 type Option int
 
@@ -75,33 +158,85 @@ const (
 	// must be used to compute a ratio rather
 	// than absolute size in pixels.
 	Ratio Option = 1 << iota
+	// Copy flag forces Crop to return a detached copy of the cropped
+	// area rather than an image sharing the source's pixel buffer,
+	// even when the source supports the fast SubImage path.
+	Copy
 )
 
+// subImager is implemented by the standard library image types
+// (*image.RGBA, *image.NRGBA, *image.YCbCr, *image.Gray, ...) and lets
+// Crop return a cropped view over the source's pixel buffer instead of
+// copying it.
+type subImager interface {
+	SubImage(r image.Rectangle) image.Image
+}
+
 // Crop retrieves an image that is a
 // cropped copy of the original img.
 //
 // The crop is made given the informations provided in config.
+//
+// When img implements SubImage(image.Rectangle) image.Image, as all the
+// standard library image types do, Crop returns a sub-image sharing the
+// source's pixel buffer instead of allocating and copying a new one.
+// Set the Copy option to always get a detached copy.
 func Crop(img image.Image, c Config) (image.Image, error) {
 	maxBounds := c.maxBounds(img.Bounds())
 	size := c.computeSize(maxBounds, image.Point{c.Width, c.Height})
-	cr := c.computedCropArea(img.Bounds(), size)
+
+	var cr image.Rectangle
+	if c.Mode == SmartCrop {
+		cr = c.smartCropArea(img, size)
+	} else {
+		cr = c.computedCropArea(img.Bounds(), size)
+	}
+
+	if c.Rotation != 0 {
+		return c.rotatedCrop(img, cr, size), nil
+	}
+
+	if clipped := img.Bounds().Intersect(cr); clipped != cr && c.Padding.mode != noPadding {
+		return c.Padding.apply(img, cr), nil
+	}
 	cr = img.Bounds().Intersect(cr)
-	result := image.NewRGBA(cr)
-	for x, dx := cr.Min.X, cr.Max.X; x < dx; x++ {
-		for y, dy := cr.Min.Y, cr.Max.Y; y < dy; y++ {
-			result.Set(x, y, result.ColorModel().Convert(img.At(x, y)))
+
+	if c.Options&Copy != Copy {
+		if si, ok := img.(subImager); ok {
+			return si.SubImage(cr), nil
 		}
 	}
+
+	result := image.NewRGBA(cr)
+	draw.Draw(result, cr, img, cr.Min, draw.Src)
 	return result, nil
 }
 
+// CropAligned crops img to width x height, anchored against align,
+// one of the 9-point compass AnchorMode values (e.g. AlignBottomRight).
+// Ratio can still be set through opts to get the biggest crop fitting
+// the given width:height ratio instead of an absolute size.
+func CropAligned(img image.Image, width, height int, align AnchorMode, opts Option) (image.Image, error) {
+	return Crop(img, Config{
+		Width:   width,
+		Height:  height,
+		Mode:    align,
+		Options: opts,
+	})
+}
+
 func (c Config) maxBounds(bounds image.Rectangle) (r image.Rectangle) {
-	if c.Mode == Centered {
+	switch {
+	case c.Mode == Centered:
 		anchor := c.centeredMin(bounds)
 		w := min(anchor.X-bounds.Min.X, bounds.Max.X-anchor.X)
 		h := min(anchor.Y-bounds.Min.Y, bounds.Max.Y-anchor.Y)
 		r = image.Rect(anchor.X-w, anchor.Y-h, anchor.X+w, anchor.Y+h)
-	} else {
+	case c.Mode.isAligned() || c.Mode == SmartCrop:
+		// Both an aligned crop and SmartCrop ignore Anchor and can
+		// use up to the whole source image.
+		r = bounds
+	default:
 		r = image.Rect(c.Anchor.X, c.Anchor.Y, bounds.Max.X, bounds.Max.Y)
 	}
 	return
@@ -127,10 +262,12 @@ func (c Config) computeSize(bounds image.Rectangle, ratio image.Point) (p image.
 // It is defined by Height, Width, Mode and
 func (c Config) computedCropArea(bounds image.Rectangle, size image.Point) (r image.Rectangle) {
 	min := bounds.Min
-	switch c.Mode {
-	case Centered:
+	switch {
+	case c.Mode == Centered:
 		rMin := c.centeredMin(bounds)
 		r = image.Rect(rMin.X-size.X/2, rMin.Y-size.Y/2, rMin.X+size.X/2, rMin.Y+size.Y/2)
+	case c.Mode.isAligned():
+		r = c.alignedRect(bounds, size)
 	default: // TopLeft
 		rMin := image.Point{min.X + c.Anchor.X, min.Y + c.Anchor.Y}
 		r = image.Rect(rMin.X, rMin.Y, rMin.X+size.X, rMin.Y+size.Y)
@@ -138,6 +275,31 @@ func (c Config) computedCropArea(bounds image.Rectangle, size image.Point) (r im
 	return
 }
 
+// alignedRect computes the crop rectangle for one of the 9-point compass
+// AnchorMode values, positioning it against the edges or center of bounds
+// instead of an explicit Anchor point.
+func (c Config) alignedRect(bounds image.Rectangle, size image.Point) (r image.Rectangle) {
+	var x0, y0 int
+	switch c.Mode {
+	case AlignTopLeft, AlignLeft, AlignBottomLeft:
+		x0 = bounds.Min.X
+	case AlignTopRight, AlignRight, AlignBottomRight:
+		x0 = bounds.Max.X - size.X
+	default: // AlignTop, AlignBottom, AlignCenter
+		x0 = bounds.Min.X + (bounds.Dx()-size.X)/2
+	}
+	switch c.Mode {
+	case AlignTopLeft, AlignTop, AlignTopRight:
+		y0 = bounds.Min.Y
+	case AlignBottomLeft, AlignBottom, AlignBottomRight:
+		y0 = bounds.Max.Y - size.Y
+	default: // AlignLeft, AlignRight, AlignCenter
+		y0 = bounds.Min.Y + (bounds.Dy()-size.Y)/2
+	}
+	r = image.Rect(x0, y0, x0+size.X, y0+size.Y)
+	return
+}
+
 func (c *Config) centeredMin(bounds image.Rectangle) (rMin image.Point) {
 	min := bounds.Min
 	if c.Anchor.X == 0 && c.Anchor.Y == 0 {
@@ -162,3 +324,12 @@ func min(a, b int) (r int) {
 	}
 	return
 }
+
+func max(a, b int) (r int) {
+	if a > b {
+		r = a
+	} else {
+		r = b
+	}
+	return
+}
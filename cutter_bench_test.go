@@ -0,0 +1,32 @@
+package cutter
+
+import (
+	"image"
+	"testing"
+)
+
+func benchmarkCrop(b *testing.B, opts Option) {
+	src := image.NewRGBA(image.Rect(0, 0, 2000, 2000))
+	cfg := Config{
+		Width:   500,
+		Height:  500,
+		Mode:    Centered,
+		Options: opts,
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := Crop(src, cfg); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkCropSubImage measures the zero-copy SubImage fast path.
+func BenchmarkCropSubImage(b *testing.B) {
+	benchmarkCrop(b, 0)
+}
+
+// BenchmarkCropCopy measures the fallback path that copies every pixel.
+func BenchmarkCropCopy(b *testing.B) {
+	benchmarkCrop(b, Copy)
+}
@@ -0,0 +1,78 @@
+package cutter
+
+import "encoding/binary"
+
+// exifOrientation scans a JPEG byte stream for an Exif APP1 segment and
+// returns its Orientation tag (1-8). It returns 1 (normal, a no-op) if
+// data isn't a JPEG or no orientation tag is found.
+func exifOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+	pos := 2
+	for pos+4 <= len(data) {
+		if data[pos] != 0xFF {
+			break
+		}
+		marker := data[pos+1]
+		if marker == 0xD8 || marker == 0xD9 {
+			pos += 2
+			continue
+		}
+		segLen := int(data[pos+2])<<8 | int(data[pos+3])
+		if segLen < 2 {
+			// segLen includes its own 2 length bytes, so anything
+			// smaller is malformed; there's no payload to read.
+			break
+		}
+		if marker == 0xE1 {
+			end := min(len(data), pos+2+segLen)
+			if o, ok := parseExifOrientation(data[pos+4 : end]); ok {
+				return o
+			}
+		}
+		if marker == 0xDA { // start of scan: entropy-coded data follows
+			break
+		}
+		pos += 2 + segLen
+	}
+	return 1
+}
+
+// parseExifOrientation reads the Orientation tag (0x0112) out of the
+// TIFF-structured payload of an Exif APP1 segment (seg starts with the
+// "Exif\0\0" marker).
+func parseExifOrientation(seg []byte) (int, bool) {
+	if len(seg) < 14 || string(seg[:6]) != "Exif\x00\x00" {
+		return 0, false
+	}
+	tiff := seg[6:]
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 0, false
+	}
+	ifdOffset := order.Uint32(tiff[4:8])
+	if int(ifdOffset)+2 > len(tiff) {
+		return 0, false
+	}
+	entryCount := int(order.Uint16(tiff[ifdOffset : ifdOffset+2]))
+	base := int(ifdOffset) + 2
+	for i := 0; i < entryCount; i++ {
+		off := base + i*12
+		if off+12 > len(tiff) {
+			break
+		}
+		tag := order.Uint16(tiff[off : off+2])
+		if tag == 0x0112 {
+			if value := order.Uint16(tiff[off+8 : off+10]); value >= 1 && value <= 8 {
+				return int(value), true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,85 @@
+package cutter
+
+import "testing"
+
+// buildExifJPEG constructs a minimal JPEG byte stream carrying a single
+// Exif IFD0 entry: the Orientation tag set to orientation.
+func buildExifJPEG(orientation uint16, bigEndian bool) []byte {
+	var order, byteOrderMark string
+	if bigEndian {
+		byteOrderMark = "MM"
+	} else {
+		byteOrderMark = "II"
+	}
+	order = byteOrderMark
+
+	put16 := func(v uint16) []byte {
+		if bigEndian {
+			return []byte{byte(v >> 8), byte(v)}
+		}
+		return []byte{byte(v), byte(v >> 8)}
+	}
+	put32 := func(v uint32) []byte {
+		if bigEndian {
+			return []byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)}
+		}
+		return []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)}
+	}
+
+	var tiff []byte
+	tiff = append(tiff, order...)
+	tiff = append(tiff, put16(42)...)
+	tiff = append(tiff, put32(8)...) // IFD0 right after the 8-byte header
+	tiff = append(tiff, put16(1)...) // one entry
+	tiff = append(tiff, put16(0x0112)...)
+	tiff = append(tiff, put16(3)...) // type SHORT
+	tiff = append(tiff, put32(1)...) // count
+	valueField := put16(orientation)
+	valueField = append(valueField, 0, 0) // SHORT value left-justified in the 4-byte field
+	tiff = append(tiff, valueField...)
+	tiff = append(tiff, put32(0)...) // no next IFD
+
+	app1 := append([]byte("Exif\x00\x00"), tiff...)
+	segLen := len(app1) + 2
+
+	data := []byte{0xFF, 0xD8, 0xFF, 0xE1, byte(segLen >> 8), byte(segLen)}
+	data = append(data, app1...)
+	data = append(data, 0xFF, 0xD9)
+	return data
+}
+
+func TestExifOrientation(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		want int
+	}{
+		{"not a jpeg", []byte("hello, world"), 1},
+		{"too short to be a jpeg", []byte{0xFF}, 1},
+		{"no app1 segment", []byte{0xFF, 0xD8, 0xFF, 0xD9}, 1},
+		{"orientation 1, little endian", buildExifJPEG(1, false), 1},
+		{"orientation 3, little endian", buildExifJPEG(3, false), 3},
+		{"orientation 6, little endian", buildExifJPEG(6, false), 6},
+		{"orientation 8, little endian", buildExifJPEG(8, false), 8},
+		{"orientation 6, big endian", buildExifJPEG(6, true), 6},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exifOrientation(tt.data); got != tt.want {
+				t.Errorf("exifOrientation() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestExifOrientationTruncatedSegment locks in that a malformed APP1
+// segment length (< 2, which leaves no room for its own length field)
+// is rejected instead of driving a negative-length slice.
+func TestExifOrientationTruncatedSegment(t *testing.T) {
+	for _, segLen := range []byte{0, 1} {
+		data := []byte{0xFF, 0xD8, 0xFF, 0xE1, 0x00, segLen, 0xFF, 0xD9}
+		if got := exifOrientation(data); got != 1 {
+			t.Errorf("segLen=%d: exifOrientation() = %d, want 1", segLen, got)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+package cutter
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+
+	_ "image/jpeg"
+	_ "image/png"
+)
+
+// CropReader decodes an image from r and crops it per c, exactly like
+// Crop. When c.AutoOrient is set, it first reads the source's EXIF
+// Orientation tag (JPEG only) and normalizes the image accordingly, so
+// uploads carrying a non-identity orientation crop as the user sees them
+// rather than as the raw pixel grid is stored.
+func CropReader(r io.Reader, c Config) (image.Image, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if c.AutoOrient {
+		img = normalizeOrientation(img, exifOrientation(data))
+	}
+	return Crop(img, c)
+}
+
+// normalizeOrientation returns img transformed according to the Exif
+// Orientation tag o (1-8), or img unchanged for 1 (normal) or any other
+// value outside that range.
+func normalizeOrientation(img image.Image, o int) image.Image {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	at := func(x, y int) color.Color { return img.At(b.Min.X+x, b.Min.Y+y) }
+
+	switch o {
+	case 2: // flip horizontal
+		out := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(w-1-x, y, at(x, y))
+			}
+		}
+		return out
+	case 3: // rotate 180
+		out := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(w-1-x, h-1-y, at(x, y))
+			}
+		}
+		return out
+	case 4: // flip vertical
+		out := image.NewRGBA(image.Rect(0, 0, w, h))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(x, h-1-y, at(x, y))
+			}
+		}
+		return out
+	case 5: // transpose (flip horizontal + rotate 270 CW)
+		out := image.NewRGBA(image.Rect(0, 0, h, w))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(y, x, at(x, y))
+			}
+		}
+		return out
+	case 6: // rotate 90 CW
+		out := image.NewRGBA(image.Rect(0, 0, h, w))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(h-1-y, x, at(x, y))
+			}
+		}
+		return out
+	case 7: // transverse (flip horizontal + rotate 90 CW)
+		out := image.NewRGBA(image.Rect(0, 0, h, w))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(h-1-y, w-1-x, at(x, y))
+			}
+		}
+		return out
+	case 8: // rotate 270 CW
+		out := image.NewRGBA(image.Rect(0, 0, h, w))
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				out.Set(y, w-1-x, at(x, y))
+			}
+		}
+		return out
+	default:
+		return img
+	}
+}
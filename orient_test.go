@@ -0,0 +1,53 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestNormalizeOrientation(t *testing.T) {
+	// 2x2 source with a distinct color in each corner, so every
+	// flip/rotate/transpose combination produces a distinguishable
+	// result.
+	red := color.RGBA{255, 0, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	green := color.RGBA{0, 255, 0, 255}
+	yellow := color.RGBA{255, 255, 0, 255}
+	src := image.NewRGBA(image.Rect(0, 0, 2, 2))
+	src.Set(0, 0, red)
+	src.Set(1, 0, blue)
+	src.Set(0, 1, green)
+	src.Set(1, 1, yellow)
+
+	tests := []struct {
+		name     string
+		o        int
+		wantSize image.Point
+		// corners, in reading order: top-left, top-right, bottom-left, bottom-right
+		corners [4]color.RGBA
+	}{
+		{"1 normal", 1, image.Pt(2, 2), [4]color.RGBA{red, blue, green, yellow}},
+		{"2 flip horizontal", 2, image.Pt(2, 2), [4]color.RGBA{blue, red, yellow, green}},
+		{"3 rotate 180", 3, image.Pt(2, 2), [4]color.RGBA{yellow, green, blue, red}},
+		{"4 flip vertical", 4, image.Pt(2, 2), [4]color.RGBA{green, yellow, red, blue}},
+		{"5 transpose", 5, image.Pt(2, 2), [4]color.RGBA{red, green, blue, yellow}},
+		{"6 rotate 90 CW", 6, image.Pt(2, 2), [4]color.RGBA{green, red, yellow, blue}},
+		{"7 transverse", 7, image.Pt(2, 2), [4]color.RGBA{yellow, blue, green, red}},
+		{"8 rotate 270 CW", 8, image.Pt(2, 2), [4]color.RGBA{blue, yellow, red, green}},
+		{"unknown value is a no-op", 99, image.Pt(2, 2), [4]color.RGBA{red, blue, green, yellow}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out := normalizeOrientation(src, tt.o)
+			if out.Bounds().Size() != tt.wantSize {
+				t.Fatalf("size = %v, want %v", out.Bounds().Size(), tt.wantSize)
+			}
+			assertColor(t, out, 0, 0, tt.corners[0])
+			assertColor(t, out, 1, 0, tt.corners[1])
+			assertColor(t, out, 0, 1, tt.corners[2])
+			assertColor(t, out, 1, 1, tt.corners[3])
+		})
+	}
+}
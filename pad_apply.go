@@ -0,0 +1,82 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+)
+
+// apply builds the full cr-sized output image, sampling img for pixels
+// inside its bounds and filling the rest per p's mode.
+func (p Padding) apply(img image.Image, cr image.Rectangle) image.Image {
+	bounds := img.Bounds()
+	out := image.NewRGBA(image.Rect(0, 0, cr.Dx(), cr.Dy()))
+	for oy := 0; oy < cr.Dy(); oy++ {
+		sy := cr.Min.Y + oy
+		for ox := 0; ox < cr.Dx(); ox++ {
+			sx := cr.Min.X + ox
+			out.Set(ox, oy, out.ColorModel().Convert(p.sample(img, bounds, sx, sy)))
+		}
+	}
+	return out
+}
+
+// sample returns the color of source pixel (x, y), falling back to p's
+// padding policy when the point lies outside bounds.
+func (p Padding) sample(img image.Image, bounds image.Rectangle, x, y int) color.Color {
+	if (image.Point{X: x, Y: y}).In(bounds) {
+		return img.At(x, y)
+	}
+	switch p.mode {
+	case padColor:
+		return p.color
+	case padEdgeExtend:
+		return img.At(
+			clampInt(x, bounds.Min.X, bounds.Max.X-1),
+			clampInt(y, bounds.Min.Y, bounds.Max.Y-1),
+		)
+	case padMirror:
+		return img.At(
+			mirrorCoord(x, bounds.Min.X, bounds.Max.X),
+			mirrorCoord(y, bounds.Min.Y, bounds.Max.Y),
+		)
+	case padWrap:
+		return img.At(
+			wrapCoord(x, bounds.Min.X, bounds.Max.X),
+			wrapCoord(y, bounds.Min.Y, bounds.Max.Y),
+		)
+	default: // padTransparent
+		return color.RGBA{}
+	}
+}
+
+func clampInt(v, lo, hi int) int {
+	switch {
+	case v < lo:
+		return lo
+	case v > hi:
+		return hi
+	default:
+		return v
+	}
+}
+
+// mirrorCoord reflects v into [lo, hi) as if the source repeated by
+// bouncing off its edges (... c b a | a b c d | d c b ...).
+func mirrorCoord(v, lo, hi int) int {
+	n := hi - lo
+	if n <= 1 {
+		return lo
+	}
+	period := 2 * n
+	v = ((v-lo)%period + period) % period
+	if v >= n {
+		v = period - 1 - v
+	}
+	return lo + v
+}
+
+// wrapCoord wraps v into [lo, hi) as if the source tiled infinitely.
+func wrapCoord(v, lo, hi int) int {
+	n := hi - lo
+	return lo + ((v-lo)%n+n)%n
+}
@@ -0,0 +1,117 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestClampMirrorWrapCoord(t *testing.T) {
+	tests := []struct {
+		name   string
+		fn     func(v, lo, hi int) int
+		v      int
+		lo, hi int
+		want   int
+	}{
+		{"clamp below", clampInt, -5, 0, 9, 0},
+		{"clamp above", clampInt, 15, 0, 9, 9},
+		{"clamp inside", clampInt, 4, 0, 9, 4},
+		{"mirror one below", mirrorCoord, -1, 0, 10, 0},
+		{"mirror two below", mirrorCoord, -2, 0, 10, 1},
+		{"mirror one above", mirrorCoord, 10, 0, 10, 9},
+		{"mirror two above", mirrorCoord, 11, 0, 10, 8},
+		{"mirror inside is unchanged", mirrorCoord, 4, 0, 10, 4},
+		{"wrap one below", wrapCoord, -1, 0, 10, 9},
+		{"wrap one above", wrapCoord, 10, 0, 10, 0},
+		{"wrap exact period", wrapCoord, 20, 0, 10, 0},
+		{"wrap inside is unchanged", wrapCoord, 4, 0, 10, 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.fn(tt.v, tt.lo, tt.hi); got != tt.want {
+				t.Errorf("got %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPaddingModes(t *testing.T) {
+	const n = 4
+	src := image.NewRGBA(image.Rect(0, 0, n, n))
+	for y := 0; y < n; y++ {
+		for x := 0; x < n; x++ {
+			src.Set(x, y, color.RGBA{uint8(x * 50), uint8(y * 50), 0, 255})
+		}
+	}
+
+	// 10x10 centered on a 4x4 source: out-of-bounds on every side.
+	// Output (ox, oy) maps to source (ox-3, oy-3).
+	base := Config{Width: 10, Height: 10, Mode: Centered}
+
+	tests := []struct {
+		name    string
+		padding Padding
+		check   func(t *testing.T, out image.Image)
+	}{
+		{"transparent", PadTransparent, func(t *testing.T, out image.Image) {
+			assertColor(t, out, 0, 0, color.RGBA{0, 0, 0, 0})
+		}},
+		{"solid color", PadColor(color.RGBA{9, 9, 9, 255}), func(t *testing.T, out image.Image) {
+			assertColor(t, out, 0, 0, color.RGBA{9, 9, 9, 255})
+		}},
+		{"edge extend", PadEdgeExtend, func(t *testing.T, out image.Image) {
+			// (0,0) -> source (-3,-3), clamped to source (0,0).
+			assertColor(t, out, 0, 0, color.RGBA{0, 0, 0, 255})
+		}},
+		{"mirror", PadMirror, func(t *testing.T, out image.Image) {
+			// (2,5) -> source (-1,2): one step left of column 0
+			// mirrors back to column 0.
+			assertColor(t, out, 2, 5, color.RGBA{0, 100, 0, 255})
+		}},
+		{"wrap", PadWrap, func(t *testing.T, out image.Image) {
+			// (2,5) -> source (-1,2): wraps to the source's last
+			// column, 3.
+			assertColor(t, out, 2, 5, color.RGBA{150, 100, 0, 255})
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := base
+			cfg.Padding = tt.padding
+			out, err := Crop(src, cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out.Bounds().Dx() != 10 || out.Bounds().Dy() != 10 {
+				t.Fatalf("got %v, want 10x10", out.Bounds())
+			}
+			tt.check(t, out)
+		})
+	}
+}
+
+func TestPaddingNotAppliedWhenCropFits(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	out, err := Crop(src, Config{Width: 10, Height: 10, Mode: Centered, Padding: PadColor(color.RGBA{9, 9, 9, 255})})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The unpadded path keeps source coordinates; a padded result
+	// would start at (0,0).
+	if want := image.Rect(5, 5, 15, 15); out.Bounds() != want {
+		t.Fatalf("got %v, want %v", out.Bounds(), want)
+	}
+}
+
+func TestPaddingZeroSizeWindow(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	out, err := Crop(src, Config{Width: 0, Height: 0, Mode: Centered, Padding: PadTransparent})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Bounds().Dx() != 0 || out.Bounds().Dy() != 0 {
+		t.Fatalf("got %v, want 0x0", out.Bounds())
+	}
+}
@@ -0,0 +1,47 @@
+package cutter
+
+import "image/color"
+
+// paddingMode identifies how out-of-bounds pixels are filled when a crop
+// rectangle exceeds the source image bounds.
+type paddingMode int
+
+const (
+	// noPadding is the zero value: Crop keeps its historical behavior
+	// of intersecting the crop rectangle with the source bounds, so
+	// the result can be smaller than Width x Height.
+	noPadding paddingMode = iota
+	padTransparent
+	padColor
+	padEdgeExtend
+	padMirror
+	padWrap
+)
+
+// Padding configures how Crop fills the parts of a requested crop
+// rectangle that fall outside the source image, so the result is always
+// exactly Width x Height. The zero value disables padding.
+type Padding struct {
+	mode  paddingMode
+	color color.Color
+}
+
+// PadTransparent fills out-of-bounds pixels with fully transparent black.
+var PadTransparent = Padding{mode: padTransparent}
+
+// PadEdgeExtend fills out-of-bounds pixels by clamping to the nearest
+// edge pixel of the source image.
+var PadEdgeExtend = Padding{mode: padEdgeExtend}
+
+// PadMirror fills out-of-bounds pixels by reflecting the source image
+// at its edges.
+var PadMirror = Padding{mode: padMirror}
+
+// PadWrap fills out-of-bounds pixels as if the source image repeated
+// infinitely in every direction.
+var PadWrap = Padding{mode: padWrap}
+
+// PadColor fills out-of-bounds pixels with a solid color.
+func PadColor(c color.Color) Padding {
+	return Padding{mode: padColor, color: c}
+}
@@ -0,0 +1,56 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// rotatedCrop samples a size-shaped axis-aligned output from img, where
+// the source window is cr rotated by Config.Rotation degrees (clockwise)
+// around its center. Out-of-source-bounds samples are filled per
+// Config.Padding (transparent black by default).
+func (c Config) rotatedCrop(img image.Image, cr image.Rectangle, size image.Point) image.Image {
+	bounds := img.Bounds()
+	theta := c.Rotation * math.Pi / 180
+	sin, cos := math.Sin(theta), math.Cos(theta)
+	cx := float64(cr.Min.X+cr.Max.X) / 2
+	cy := float64(cr.Min.Y+cr.Max.Y) / 2
+
+	out := image.NewRGBA(image.Rect(0, 0, size.X, size.Y))
+	for oy := 0; oy < size.Y; oy++ {
+		dy := float64(oy) - float64(size.Y)/2
+		for ox := 0; ox < size.X; ox++ {
+			dx := float64(ox) - float64(size.X)/2
+			sx := cx + dx*cos - dy*sin
+			sy := cy + dx*sin + dy*cos
+			out.Set(ox, oy, sampleBilinear(img, bounds, sx, sy, c.Padding))
+		}
+	}
+	return out
+}
+
+// sampleBilinear interpolates img at the fractional source coordinate
+// (x, y), using pad's policy to fill any of the four surrounding
+// integer samples that fall outside bounds.
+func sampleBilinear(img image.Image, bounds image.Rectangle, x, y float64, pad Padding) color.Color {
+	x0, y0 := math.Floor(x), math.Floor(y)
+	fx, fy := x-x0, y-y0
+	ix0, iy0 := int(x0), int(y0)
+
+	c00 := pad.sample(img, bounds, ix0, iy0)
+	c10 := pad.sample(img, bounds, ix0+1, iy0)
+	c01 := pad.sample(img, bounds, ix0, iy0+1)
+	c11 := pad.sample(img, bounds, ix0+1, iy0+1)
+
+	return lerpColor(lerpColor(c00, c10, fx), lerpColor(c01, c11, fx), fy)
+}
+
+func lerpColor(a, b color.Color, t float64) color.Color {
+	ar, ag, ab, aa := a.RGBA()
+	br, bg, bb, ba := b.RGBA()
+	lerp := func(x, y uint32) uint8 {
+		return uint8((float64(x) + (float64(y)-float64(x))*t) / 257)
+	}
+	return color.RGBA{R: lerp(ar, br), G: lerp(ag, bg), B: lerp(ab, bb), A: lerp(aa, ba)}
+}
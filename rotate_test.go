@@ -0,0 +1,59 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestRotatedCropDimensionsAndUniformColor(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	fill := color.RGBA{100, 150, 200, 255}
+	for y := 0; y < 40; y++ {
+		for x := 0; x < 40; x++ {
+			src.Set(x, y, fill)
+		}
+	}
+
+	// The rotated window (half-diagonal ~9.4px) stays well inside the
+	// 40x40 source when centered, so every output pixel should sample
+	// the source's single uniform color, with no padding kicking in.
+	out, err := Crop(src, Config{Width: 10, Height: 16, Mode: Centered, Rotation: 37})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Bounds().Dx() != 10 || out.Bounds().Dy() != 16 {
+		t.Fatalf("got %v, want 10x16", out.Bounds())
+	}
+	for y := 0; y < 16; y++ {
+		for x := 0; x < 10; x++ {
+			assertColor(t, out, x, y, fill)
+		}
+	}
+}
+
+func TestRotatedCropPaddingFill(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	padColor := color.RGBA{9, 9, 9, 255}
+
+	// A 30x30 window rotated around a 10x10 source: its corners land
+	// far outside the source and must be filled per Padding.
+	out, err := Crop(src, Config{
+		Width: 30, Height: 30, Mode: Centered,
+		Rotation: 45,
+		Padding:  PadColor(padColor),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertColor(t, out, 0, 0, padColor)
+}
+
+func TestRotatedCropDefaultPaddingIsTransparent(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	out, err := Crop(src, Config{Width: 30, Height: 30, Mode: Centered, Rotation: 45})
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertColor(t, out, 0, 0, color.RGBA{0, 0, 0, 0})
+}
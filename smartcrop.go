@@ -0,0 +1,205 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"math"
+)
+
+// SmartCropOptions tunes the SmartCrop content-aware algorithm. The zero
+// value uses sensible defaults.
+type SmartCropOptions struct {
+	// Step is the pixel stride used when sliding the candidate window
+	// over the image during the coarse search pass. Defaults to 8.
+	Step int
+	// EdgeWeight weighs the Sobel edge-energy term of the importance
+	// score. Defaults to 1 when all three weights are zero.
+	EdgeWeight float64
+	// SaturationWeight weighs the HSL saturation term. Defaults to 0.2
+	// when all three weights are zero.
+	SaturationWeight float64
+	// SkinWeight weighs the skin-tone prior. Defaults to 0.2 when all
+	// three weights are zero. If any weight is set, the others are used
+	// as given, so a weight left at 0 disables its term.
+	SkinWeight float64
+	// CenterBias, when positive, multiplies each candidate's score by
+	// a Gaussian favoring windows closer to the image center. 0 (the
+	// default) disables it.
+	CenterBias float64
+}
+
+func (o SmartCropOptions) withDefaults() SmartCropOptions {
+	if o.Step <= 0 {
+		o.Step = 8
+	}
+	if o.EdgeWeight == 0 && o.SaturationWeight == 0 && o.SkinWeight == 0 {
+		o.EdgeWeight = 1
+		o.SaturationWeight = 0.2
+		o.SkinWeight = 0.2
+	}
+	return o
+}
+
+// smartCropArea finds the size-shaped window of img that maximizes the
+// importance score computed by importanceMap. A summed-area table makes
+// scoring any candidate window O(1), so the search can afford a coarse
+// sliding pass over the whole image followed by a pixel-accurate pass
+// around the best coarse candidate.
+func (c Config) smartCropArea(img image.Image, size image.Point) image.Rectangle {
+	bounds := img.Bounds()
+	if size.X >= bounds.Dx() || size.Y >= bounds.Dy() {
+		// No room to slide a window; degrade to a plain centered crop.
+		fallback := c
+		fallback.Mode = Centered
+		fallback.Anchor = image.Point{}
+		return fallback.computedCropArea(bounds, size)
+	}
+
+	opts := c.SmartCrop.withDefaults()
+	table := integralImage(importanceMap(img, opts))
+
+	best := bounds.Min
+	bestScore := math.Inf(-1)
+	for y := bounds.Min.Y; y+size.Y <= bounds.Max.Y; y += opts.Step {
+		for x := bounds.Min.X; x+size.X <= bounds.Max.X; x += opts.Step {
+			if s := windowScore(table, bounds, image.Pt(x, y), size, opts); s > bestScore {
+				bestScore, best = s, image.Pt(x, y)
+			}
+		}
+	}
+
+	// Refine at pixel granularity around the best coarse candidate.
+	lo := image.Pt(max(bounds.Min.X, best.X-opts.Step), max(bounds.Min.Y, best.Y-opts.Step))
+	hi := image.Pt(min(bounds.Max.X-size.X, best.X+opts.Step), min(bounds.Max.Y-size.Y, best.Y+opts.Step))
+	for y := lo.Y; y <= hi.Y; y++ {
+		for x := lo.X; x <= hi.X; x++ {
+			if s := windowScore(table, bounds, image.Pt(x, y), size, opts); s > bestScore {
+				bestScore, best = s, image.Pt(x, y)
+			}
+		}
+	}
+
+	return image.Rectangle{Min: best, Max: best.Add(size)}
+}
+
+// windowScore returns the summed importance inside the size-shaped window
+// with top-left corner min, optionally boosted by a center-bias Gaussian.
+func windowScore(table [][]float64, bounds image.Rectangle, min, size image.Point, opts SmartCropOptions) float64 {
+	score := rectSum(table, bounds, image.Rectangle{Min: min, Max: min.Add(size)})
+	if opts.CenterBias > 0 {
+		center := image.Pt(bounds.Min.X+bounds.Dx()/2, bounds.Min.Y+bounds.Dy()/2)
+		winCenter := min.Add(image.Pt(size.X/2, size.Y/2))
+		dx := float64(winCenter.X - center.X)
+		dy := float64(winCenter.Y - center.Y)
+		sigma := float64(bounds.Dx()+bounds.Dy()) / 4
+		g := math.Exp(-(dx*dx + dy*dy) / (2 * sigma * sigma))
+		score *= 1 + opts.CenterBias*g
+	}
+	return score
+}
+
+// importanceMap computes a per-pixel visual-interest score combining
+// Sobel edge energy, HSL saturation (peaking at mid-lightness) and a
+// skin-tone prior, each scaled by its configured weight.
+func importanceMap(img image.Image, opts SmartCropOptions) [][]float64 {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	gray := make([][]float64, h)
+	rgba := make([][]color.RGBA, h)
+	for y := 0; y < h; y++ {
+		gray[y] = make([]float64, w)
+		rgba[y] = make([]color.RGBA, w)
+		for x := 0; x < w; x++ {
+			px := color.RGBAModel.Convert(img.At(bounds.Min.X+x, bounds.Min.Y+y)).(color.RGBA)
+			rgba[y][x] = px
+			gray[y][x] = 0.299*float64(px.R) + 0.587*float64(px.G) + 0.114*float64(px.B)
+		}
+	}
+
+	m := make([][]float64, h)
+	for y := 0; y < h; y++ {
+		m[y] = make([]float64, w)
+		for x := 0; x < w; x++ {
+			m[y][x] = opts.EdgeWeight*sobelEnergy(gray, x, y, w, h) +
+				opts.SaturationWeight*saturationScore(rgba[y][x]) +
+				opts.SkinWeight*skinScore(rgba[y][x])
+		}
+	}
+	return m
+}
+
+// sobelEnergy returns |Gx|+|Gy| for the 3x3 Sobel operator centered at
+// (x, y), or 0 on the one-pixel border where the kernel doesn't fit.
+func sobelEnergy(gray [][]float64, x, y, w, h int) float64 {
+	if x == 0 || y == 0 || x == w-1 || y == h-1 {
+		return 0
+	}
+	gx := -gray[y-1][x-1] - 2*gray[y][x-1] - gray[y+1][x-1] +
+		gray[y-1][x+1] + 2*gray[y][x+1] + gray[y+1][x+1]
+	gy := -gray[y-1][x-1] - 2*gray[y-1][x] - gray[y-1][x+1] +
+		gray[y+1][x-1] + 2*gray[y+1][x] + gray[y+1][x+1]
+	return math.Abs(gx) + math.Abs(gy)
+}
+
+// saturationScore returns the HSL saturation of c, scaled down as
+// lightness moves away from the mid-point where saturation is most
+// visually salient.
+func saturationScore(c color.RGBA) float64 {
+	r, g, b := float64(c.R)/255, float64(c.G)/255, float64(c.B)/255
+	hi := math.Max(r, math.Max(g, b))
+	lo := math.Min(r, math.Min(g, b))
+	if hi == lo {
+		return 0
+	}
+	l := (hi + lo) / 2
+	d := hi - lo
+	var s float64
+	if l > 0.5 {
+		s = d / (2 - hi - lo)
+	} else {
+		s = d / (hi + lo)
+	}
+	return s * (1 - math.Abs(l-0.5)*2)
+}
+
+// skinScore returns 1 if c falls within a common RGB skin-tone heuristic,
+// 0 otherwise.
+func skinScore(c color.RGBA) float64 {
+	r, g, b := int(c.R), int(c.G), int(c.B)
+	hi := max(r, max(g, b))
+	lo := min(r, min(g, b))
+	if r > 95 && g > 40 && b > 20 && r > g && r > b && hi-lo > 15 {
+		return 1
+	}
+	return 0
+}
+
+// integralImage builds a summed-area table (with a leading zero row and
+// column) so the sum of importance values inside any axis-aligned
+// rectangle can be queried in O(1) via rectSum.
+func integralImage(m [][]float64) [][]float64 {
+	h := len(m)
+	w := 0
+	if h > 0 {
+		w = len(m[0])
+	}
+	table := make([][]float64, h+1)
+	for y := range table {
+		table[y] = make([]float64, w+1)
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			table[y+1][x+1] = m[y][x] + table[y][x+1] + table[y+1][x] - table[y][x]
+		}
+	}
+	return table
+}
+
+// rectSum returns the sum of importance values inside r, given the
+// summed-area table for the image occupying bounds.
+func rectSum(table [][]float64, bounds, r image.Rectangle) float64 {
+	x0, y0 := r.Min.X-bounds.Min.X, r.Min.Y-bounds.Min.Y
+	x1, y1 := r.Max.X-bounds.Min.X, r.Max.Y-bounds.Min.Y
+	return table[y1][x1] - table[y0][x1] - table[y1][x0] + table[y0][x0]
+}
@@ -0,0 +1,131 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestIntegralImageRectSum(t *testing.T) {
+	m := [][]float64{
+		{1, 2, 3},
+		{4, 5, 6},
+		{7, 8, 9},
+	}
+	table := integralImage(m)
+	bounds := image.Rect(0, 0, 3, 3)
+
+	tests := []struct {
+		r    image.Rectangle
+		want float64
+	}{
+		{image.Rect(0, 0, 3, 3), 45},
+		{image.Rect(0, 0, 1, 1), 1},
+		{image.Rect(1, 1, 3, 3), 5 + 6 + 8 + 9},
+		{image.Rect(0, 0, 2, 1), 1 + 2},
+		{image.Rect(2, 2, 3, 3), 9},
+	}
+	for _, tt := range tests {
+		if got := rectSum(table, bounds, tt.r); got != tt.want {
+			t.Errorf("rectSum(%v) = %v, want %v", tt.r, got, tt.want)
+		}
+	}
+}
+
+func TestSkinScore(t *testing.T) {
+	tests := []struct {
+		name string
+		c    color.RGBA
+		want float64
+	}{
+		{"typical skin tone", color.RGBA{200, 150, 120, 255}, 1},
+		{"pure blue", color.RGBA{0, 0, 255, 255}, 0},
+		{"low contrast gray", color.RGBA{100, 100, 100, 255}, 0},
+		{"dark, fails the green/blue floor", color.RGBA{100, 30, 10, 255}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := skinScore(tt.c); got != tt.want {
+				t.Errorf("skinScore(%v) = %v, want %v", tt.c, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSaturationScore(t *testing.T) {
+	gray := saturationScore(color.RGBA{128, 128, 128, 255})
+	if gray != 0 {
+		t.Errorf("gray saturationScore = %v, want 0", gray)
+	}
+
+	mid := saturationScore(color.RGBA{200, 50, 50, 255})      // saturated, near mid lightness
+	dark := saturationScore(color.RGBA{20, 5, 5, 255})        // saturated but dark
+	bright := saturationScore(color.RGBA{250, 235, 235, 255}) // saturated but near white
+	if mid <= dark {
+		t.Errorf("expected mid-lightness saturation (%v) to score above dark saturation (%v)", mid, dark)
+	}
+	if mid <= bright {
+		t.Errorf("expected mid-lightness saturation (%v) to score above near-white saturation (%v)", mid, bright)
+	}
+}
+
+func TestSmartCropPicksInterestingRegion(t *testing.T) {
+	const w, h = 120, 80
+	src := image.NewRGBA(image.Rect(0, 0, w, h))
+	flat := color.RGBA{120, 120, 120, 255}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			src.Set(x, y, flat)
+		}
+	}
+	// A small high-contrast checkerboard patch off-center, outside the
+	// window a plain centered crop would pick, to give SmartCrop
+	// something to find.
+	patch := image.Rect(90, 30, 110, 50)
+	for y := patch.Min.Y; y < patch.Max.Y; y++ {
+		for x := patch.Min.X; x < patch.Max.X; x++ {
+			if (x+y)%2 == 0 {
+				src.Set(x, y, color.RGBA{255, 255, 255, 255})
+			} else {
+				src.Set(x, y, color.RGBA{0, 0, 0, 255})
+			}
+		}
+	}
+
+	out, err := Crop(src, Config{Width: 30, Height: 30, Mode: SmartCrop})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rOut, ok := out.(*image.RGBA)
+	if !ok {
+		t.Fatalf("expected *image.RGBA, got %T", out)
+	}
+	if rOut.Bounds().Dx() != 30 || rOut.Bounds().Dy() != 30 {
+		t.Fatalf("got %v, want 30x30", rOut.Bounds())
+	}
+	if rOut.Bounds().Intersect(patch).Empty() {
+		t.Errorf("SmartCrop chose %v, which does not overlap the interesting patch %v", rOut.Bounds(), patch)
+	}
+}
+
+func TestSmartCropDegenerateTinyImage(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 5, 5))
+	out, err := Crop(src, Config{Width: 20, Height: 20, Mode: SmartCrop})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Bounds().Dx() != 5 || out.Bounds().Dy() != 5 {
+		t.Fatalf("expected fallback clamped to source size, got %v", out.Bounds())
+	}
+}
+
+func TestSmartCropZeroSizeWindow(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 20, 20))
+	out, err := Crop(src, Config{Width: 0, Height: 0, Mode: SmartCrop})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.Bounds().Dx() != 0 || out.Bounds().Dy() != 0 {
+		t.Fatalf("got %v, want a 0x0 result", out.Bounds())
+	}
+}
@@ -0,0 +1,16 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+// assertColor fails t if img.At(x, y), converted to color.RGBA, isn't want.
+func assertColor(t *testing.T, img image.Image, x, y int, want color.RGBA) {
+	t.Helper()
+	got := color.RGBAModel.Convert(img.At(x, y)).(color.RGBA)
+	if got != want {
+		t.Errorf("at (%d,%d): got %v, want %v", x, y, got, want)
+	}
+}
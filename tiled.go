@@ -0,0 +1,98 @@
+package cutter
+
+import (
+	"errors"
+	"image"
+	"image/draw"
+)
+
+// errRotationUnsupported is returned by CropTiled and CropInto when
+// Config.Rotation is set, since the rotated-sampling path needs the
+// whole destination in memory at once.
+var errRotationUnsupported = errors.New("cutter: Rotation is not supported by CropTiled/CropInto")
+
+// errPaddingUnsupported is returned by CropTiled and CropInto when
+// Config.Padding is set, since draw.Draw silently leaves out-of-bounds
+// destination pixels untouched rather than applying a padding policy.
+var errPaddingUnsupported = errors.New("cutter: Padding is not supported by CropTiled/CropInto")
+
+// errInvalidTileSize is returned by CropTiled when tileSize is not
+// positive, since the walk could otherwise never advance.
+var errInvalidTileSize = errors.New("cutter: tileSize must be positive")
+
+// TileFunc receives one tile of a CropTiled walk. tile holds that
+// tile's pixels; r is its rectangle within the overall crop, in
+// (0,0)-based destination coordinates. tile must not be retained after
+// TileFunc returns, since it may be reused for the next tile.
+type TileFunc func(tile *image.RGBA, r image.Rectangle) error
+
+// CropTiled crops img per c like Crop, but instead of allocating the
+// whole crop region up front, walks it in tileSize x tileSize chunks
+// and invokes fn with each tile. This bounds peak memory when cropping
+// a small region out of a very large source image, or producing a very
+// large crop that doesn't need to live in memory all at once. Each tile
+// is built with draw.Draw rather than a per-pixel Set loop.
+func CropTiled(img image.Image, c Config, tileSize int, fn TileFunc) error {
+	if tileSize <= 0 {
+		return errInvalidTileSize
+	}
+	cr, err := c.tiledCropArea(img)
+	if err != nil {
+		return err
+	}
+
+	// Reused across iterations: a tile at the full tileSize is only
+	// materialized once, and partial tiles (the last row/column) use a
+	// SubImage view over the same backing pixel buffer.
+	buf := image.NewRGBA(image.Rect(0, 0, tileSize, tileSize))
+
+	for y := cr.Min.Y; y < cr.Max.Y; y += tileSize {
+		for x := cr.Min.X; x < cr.Max.X; x += tileSize {
+			src := image.Rect(x, y, min(x+tileSize, cr.Max.X), min(y+tileSize, cr.Max.Y))
+			tile := buf.SubImage(image.Rect(0, 0, src.Dx(), src.Dy())).(*image.RGBA)
+			draw.Draw(tile, tile.Bounds(), img, src.Min, draw.Src)
+
+			offset := image.Pt(src.Min.X-cr.Min.X, src.Min.Y-cr.Min.Y)
+			if err := fn(tile, image.Rectangle{Min: offset, Max: offset.Add(src.Size())}); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CropInto crops img per c like Crop, drawing the result directly into
+// dst (at dst's own bounds' origin) via draw.Draw instead of allocating
+// and returning a new image.
+func CropInto(dst draw.Image, img image.Image, c Config) error {
+	cr, err := c.tiledCropArea(img)
+	if err != nil {
+		return err
+	}
+	db := dst.Bounds()
+	dr := image.Rect(db.Min.X, db.Min.Y, db.Min.X+cr.Dx(), db.Min.Y+cr.Dy())
+	draw.Draw(dst, dr, img, cr.Min, draw.Src)
+	return nil
+}
+
+// tiledCropArea computes the same (bounds-clipped) crop rectangle Crop
+// would use, for the tile- and destination-oriented entry points, which
+// don't support Rotation or Padding.
+func (c Config) tiledCropArea(img image.Image) (image.Rectangle, error) {
+	if c.Rotation != 0 {
+		return image.Rectangle{}, errRotationUnsupported
+	}
+	if c.Padding.mode != noPadding {
+		return image.Rectangle{}, errPaddingUnsupported
+	}
+	maxBounds := c.maxBounds(img.Bounds())
+	size := c.computeSize(maxBounds, image.Point{c.Width, c.Height})
+
+	var cr image.Rectangle
+	if c.Mode == SmartCrop {
+		cr = c.smartCropArea(img, size)
+	} else {
+		cr = c.computedCropArea(img.Bounds(), size)
+	}
+	return img.Bounds().Intersect(cr), nil
+}
@@ -0,0 +1,106 @@
+package cutter
+
+import (
+	"image"
+	"image/color"
+	"testing"
+	"time"
+)
+
+func TestCropTiledReusesBuffer(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 40, 40))
+	var first *uint8
+	tiles := 0
+	err := CropTiled(src, Config{Width: 40, Height: 40}, 16, func(tile *image.RGBA, r image.Rectangle) error {
+		tiles++
+		if len(tile.Pix) == 0 {
+			return nil
+		}
+		if first == nil {
+			first = &tile.Pix[0]
+		} else if &tile.Pix[0] != first {
+			t.Errorf("tile %d: backing array changed, CropTiled is allocating a new buffer per tile", tiles)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tiles < 2 {
+		t.Fatalf("expected multiple tiles, got %d", tiles)
+	}
+}
+
+func TestCropTiledCoversCropExactlyOnce(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	seen := make([][]bool, 50)
+	for i := range seen {
+		seen[i] = make([]bool, 50)
+	}
+
+	err := CropTiled(src, Config{Width: 50, Height: 50, Mode: Centered}, 16, func(tile *image.RGBA, r image.Rectangle) error {
+		if tile.Bounds().Size() != r.Size() {
+			t.Fatalf("tile size %v != region size %v", tile.Bounds().Size(), r.Size())
+		}
+		for y := r.Min.Y; y < r.Max.Y; y++ {
+			for x := r.Min.X; x < r.Max.X; x++ {
+				if seen[y][x] {
+					t.Fatalf("pixel (%d,%d) covered by more than one tile", x, y)
+				}
+				seen[y][x] = true
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for y := range seen {
+		for x := range seen[y] {
+			if !seen[y][x] {
+				t.Fatalf("pixel (%d,%d) was never covered by any tile", x, y)
+			}
+		}
+	}
+}
+
+func TestCropIntoNonZeroOriginDestination(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 100, 100))
+	fill := color.RGBA{255, 0, 0, 255}
+	for y := 40; y < 60; y++ {
+		for x := 40; x < 60; x++ {
+			src.Set(x, y, fill)
+		}
+	}
+
+	// dst is a SubImage whose Bounds() doesn't start at (0,0); CropInto
+	// must draw relative to dst's own origin, not the absolute origin.
+	backing := image.NewRGBA(image.Rect(0, 0, 50, 50))
+	dst := backing.SubImage(image.Rect(10, 10, 30, 30)).(*image.RGBA)
+
+	if err := CropInto(dst, src, Config{Width: 20, Height: 20, Mode: Centered}); err != nil {
+		t.Fatal(err)
+	}
+	assertColor(t, backing, 10, 10, fill)
+	assertColor(t, backing, 29, 29, fill)
+	assertColor(t, backing, 0, 0, color.RGBA{})
+}
+
+func TestCropTiledRejectsNonPositiveTileSize(t *testing.T) {
+	src := image.NewRGBA(image.Rect(0, 0, 10, 10))
+	for _, sz := range []int{0, -1} {
+		done := make(chan error, 1)
+		go func(sz int) {
+			done <- CropTiled(src, Config{Width: 5, Height: 5}, sz, func(*image.RGBA, image.Rectangle) error { return nil })
+		}(sz)
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Errorf("tileSize=%d: expected error", sz)
+			}
+		case <-time.After(500 * time.Millisecond):
+			t.Fatalf("tileSize=%d: hung", sz)
+		}
+	}
+}